@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"fyne.io/fyne/v2/cmd/fyne/internal/templates"
 	"fyne.io/fyne/v2/cmd/fyne/internal/util"
@@ -37,24 +39,57 @@ func (p *Packager) packageGopherJS() error {
 		return err
 	}
 
-	// Download webgl-debug.js directly from the KhronosGroup repository when needed
+	// Debug web builds need webgl-debug.js alongside the app; release builds don't load it.
 	if !p.release {
-		r, err := http.Get("https://raw.githubusercontent.com/KhronosGroup/WebGLDeveloperTools/b42e702487d02d5278814e0fe2e2888d234893e6/src/debug/webgl-debug.js")
-		if err != nil {
-			return err
-		}
-		defer r.Body.Close()
+		return p.writeWebGLDebug(appDir)
+	}
+
+	return nil
+}
 
-		webglDebugFile := filepath.Join(appDir, "webgl-debug.js")
-		out, err := os.Create(webglDebugFile)
-		if err != nil {
-			return err
+// writeWebGLDebug writes webgl-debug.js into appDir for debug web builds. It defaults to the
+// embedded copy, so no network access is required. --webgl-debug overrides it with a local file,
+// or fetches it from a remote http(s) URL; --offline rejects an override that names a remote URL.
+func (p *Packager) writeWebGLDebug(appDir string) error {
+	webglDebugFile := filepath.Join(appDir, "webgl-debug.js")
+
+	if p.webglDebug != "" {
+		if isRemotePath(p.webglDebug) {
+			if p.offline {
+				return fmt.Errorf("--webgl-debug %q requires network access, which --offline forbids", p.webglDebug)
+			}
+
+			return downloadFile(p.webglDebug, webglDebugFile)
 		}
-		defer out.Close()
 
-		_, err = io.Copy(out, r.Body)
+		return util.CopyFile(p.webglDebug, webglDebugFile)
+	}
+
+	return os.WriteFile(webglDebugFile, templates.WebGLDebugJS, 0644)
+}
+
+func isRemotePath(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+// downloadFile fetches url over HTTP(S) and writes its body to dest.
+func downloadFile(url, dest string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %q: unexpected status %s", url, resp.Status)
+	}
+
+	out, err := os.Create(dest)
+	if err != nil {
 		return err
 	}
+	defer out.Close()
 
-	return nil
+	_, err = io.Copy(out, resp.Body)
+	return err
 }
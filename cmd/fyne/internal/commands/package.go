@@ -0,0 +1,32 @@
+package commands
+
+import "flag"
+
+// Packager wraps a built executable into the target platform's package format.
+type Packager struct {
+	os      string
+	srcDir  string
+	dir     string
+	name    string
+	icon    string
+	exe     string
+	release bool
+
+	// offline disallows network access during packaging, so a build fails instead of silently
+	// fetching a remote asset (for example a --webgl-debug URL).
+	offline bool
+
+	// webglDebug overrides the embedded webgl-debug.js used by debug gopherjs/wasm packages with
+	// a local file, or a remote URL when not running with --offline.
+	webglDebug string
+}
+
+// addFlags registers the command-line flags shared by `fyne package` targets.
+func (p *Packager) addFlags() {
+	flag.StringVar(&p.os, "os", "", "The operating system to target (android, android/arm, ios, darwin, linux, windows, wasm, gopherjs)")
+	flag.StringVar(&p.name, "name", "", "The name of the application, default is the executable file name")
+	flag.StringVar(&p.icon, "icon", "", "The name of the application icon file")
+	flag.BoolVar(&p.release, "release", false, "Should this package be prepared for release? (disable debug etc)")
+	flag.BoolVar(&p.offline, "offline", false, "Disallow network access during packaging, failing instead of fetching a remote asset")
+	flag.StringVar(&p.webglDebug, "webgl-debug", "", "A local file or URL to use instead of the embedded webgl-debug.js for debug web packages")
+}
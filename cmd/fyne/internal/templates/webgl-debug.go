@@ -0,0 +1,9 @@
+package templates
+
+import _ "embed"
+
+// WebGLDebugJS is webgl-debug.js, a minimal custom shim so debug web packages don't need to
+// download a WebGLDebugUtils implementation from the network on every build.
+//
+//go:embed webgl-debug.js
+var WebGLDebugJS []byte
@@ -8,6 +8,7 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	col "fyne.io/fyne/v2/internal/color"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
@@ -17,7 +18,7 @@ const (
 	padHeight = 16
 )
 
-// Dialog is the common API for any dialog window with a single dismiss button
+// Dialog is the common API for any dialog window
 type Dialog interface {
 	Show()
 	Hide()
@@ -28,6 +29,48 @@ type Dialog interface {
 
 	// Since: 2.1
 	MinSize() fyne.Size
+
+	// SetContentScroll sets whether the dialog content may be wrapped in a
+	// scroll container when it does not fit within the parent canvas.
+	// The default is container.ScrollBoth, so content that overflows the
+	// window is scrollable automatically; pass container.ScrollNone to
+	// disable this and let the dialog grow instead.
+	//
+	// Since: 2.3
+	SetContentScroll(direction container.ScrollDirection)
+
+	// EnableButton enables the button at the given index, for dialogs created with NewCustomMulti.
+	//
+	// Since: 2.3
+	EnableButton(idx int)
+
+	// DisableButton disables the button at the given index, for dialogs created with NewCustomMulti.
+	//
+	// Since: 2.3
+	DisableButton(idx int)
+
+	// SetResponseCallback sets a callback that is invoked with the index of the tapped button,
+	// for dialogs created with NewCustomMulti.
+	//
+	// Since: 2.3
+	SetResponseCallback(callback func(idx int))
+
+	// SetMinContentSize sets a floor for the dialog content's MinSize, so the dialog is not
+	// made narrower or shorter than size because of a smaller content MinSize. While content
+	// scrolling is enabled, the floored size is still clamped to fit the parent canvas.
+	//
+	// Since: 2.3
+	SetMinContentSize(size fyne.Size)
+}
+
+// DialogButton describes a single button within a multi-button dialog created with NewCustomMulti.
+//
+// Since: 2.3
+type DialogButton struct {
+	Label      string
+	Importance widget.Importance
+	Disabled   bool
+	OnTapped   func()
 }
 
 // Declare conformity to Dialog interface
@@ -43,8 +86,15 @@ type dialog struct {
 	bg             *themedBackground
 	content, label fyne.CanvasObject
 	dismiss        *widget.Button
+	buttons        []*widget.Button
 	parent         fyne.Window
+
+	contentScroll  *container.Scroll
+	scrollDir      container.ScrollDirection
+	minContentSize fyne.Size
 	layout         *dialogLayout
+
+	responseCallback func(idx int)
 }
 
 func (d *dialog) Hide() {
@@ -77,10 +127,76 @@ func (d *dialog) Resize(size fyne.Size) {
 
 // SetDismissText allows custom text to be set in the dismiss button
 func (d *dialog) SetDismissText(label string) {
+	if d.dismiss == nil {
+		return
+	}
 	d.dismiss.SetText(label)
 	d.win.Refresh()
 }
 
+// EnableButton enables the button at the given index, for dialogs created with NewCustomMulti.
+//
+// Since: 2.3
+func (d *dialog) EnableButton(idx int) {
+	if idx < 0 || idx >= len(d.buttons) {
+		return
+	}
+	d.buttons[idx].Enable()
+}
+
+// DisableButton disables the button at the given index, for dialogs created with NewCustomMulti.
+//
+// Since: 2.3
+func (d *dialog) DisableButton(idx int) {
+	if idx < 0 || idx >= len(d.buttons) {
+		return
+	}
+	d.buttons[idx].Disable()
+}
+
+// SetResponseCallback sets a callback that is invoked with the index of the tapped button,
+// for dialogs created with NewCustomMulti.
+//
+// Since: 2.3
+func (d *dialog) SetResponseCallback(callback func(idx int)) {
+	d.responseCallback = callback
+}
+
+// SetMinContentSize sets a floor for the dialog content's MinSize, so the dialog is not made
+// narrower or shorter than size because of a smaller content MinSize. While content scrolling
+// is enabled, the floored size is still clamped to fit the parent canvas.
+//
+// Since: 2.3
+func (d *dialog) SetMinContentSize(size fyne.Size) {
+	d.minContentSize = size
+	if d.contentScroll != nil {
+		d.contentScroll.Content = d.wrappedContent()
+		d.contentScroll.Refresh()
+	}
+}
+
+// wrappedContent returns d.content, wrapped with a layout.NewMinSizeLayout floor if
+// d.minContentSize has been set.
+func (d *dialog) wrappedContent() fyne.CanvasObject {
+	if d.minContentSize.IsZero() {
+		return d.content
+	}
+
+	return container.New(layout.NewMinSizeLayout(d.minContentSize, layout.NewStackLayout()), d.content)
+}
+
+// SetContentScroll sets whether the dialog content may be wrapped in a
+// scroll container when it does not fit within the parent canvas.
+//
+// Since: 2.3
+func (d *dialog) SetContentScroll(direction container.ScrollDirection) {
+	d.scrollDir = direction
+	if d.contentScroll != nil {
+		d.contentScroll.Direction = direction
+		d.contentScroll.Refresh()
+	}
+}
+
 // SetOnClosed allows to set a callback function that is called when
 // the dialog is closed
 func (d *dialog) SetOnClosed(closed func()) {
@@ -102,25 +218,38 @@ func (d *dialog) hideWithResponse(resp bool) {
 	}
 }
 
+func (d *dialog) hideWithResponseIndex(idx int) {
+	d.win.Hide()
+	if d.callback != nil {
+		d.callback(false)
+	}
+	if d.responseCallback != nil {
+		d.responseCallback(idx)
+	}
+}
+
 func (d *dialog) create(buttons fyne.CanvasObject) {
 	d.bg = newThemedBackground()
 	d.label = widget.NewLabelWithStyle(d.title, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
 
-	content := container.New(d.layout,
+	d.contentScroll = container.NewScroll(d.wrappedContent())
+	d.contentScroll.Direction = d.scrollDir
+
+	popup := container.New(d.layout,
 		&canvas.Image{Resource: d.icon},
 		d.bg,
-		d.content,
+		d.contentScroll,
 		buttons,
 		d.label,
 	)
 
-	d.win = widget.NewModalPopUp(content, d.parent.Canvas())
+	d.win = widget.NewModalPopUp(popup, d.parent.Canvas())
 	d.Refresh()
 }
 
 // The method .create() needs to be called before the dialog cna be shown.
 func newDialog(title, message string, icon fyne.Resource, callback func(bool), parent fyne.Window) *dialog {
-	d := &dialog{content: newCenterLabel(message), title: title, icon: icon, parent: parent}
+	d := &dialog{content: newCenterLabel(message), title: title, icon: icon, parent: parent, scrollDir: container.ScrollBoth}
 	d.layout = &dialogLayout{d: d}
 	d.callback = callback
 
@@ -131,6 +260,39 @@ func newCenterLabel(message string) fyne.CanvasObject {
 	return &widget.Label{Text: message, Alignment: fyne.TextAlignCenter}
 }
 
+// NewCustomMulti creates and returns a dialog with an arbitrary row of buttons, each with its own
+// label, importance, initial enabled state and tap callback. Use SetResponseCallback to be notified
+// of which button index was tapped, and EnableButton / DisableButton to toggle them afterwards.
+// This allows Yes/No/Cancel or Retry/Ignore/Abort style dialogs without a single dismiss button.
+//
+// Since: 2.3
+func NewCustomMulti(title string, buttons []*DialogButton, content fyne.CanvasObject, parent fyne.Window) Dialog {
+	d := &dialog{content: content, title: title, parent: parent, scrollDir: container.ScrollBoth}
+	d.layout = &dialogLayout{d: d}
+
+	row := container.NewHBox()
+	d.buttons = make([]*widget.Button, len(buttons))
+	for i, def := range buttons {
+		idx, def := i, def
+		btn := widget.NewButton(def.Label, func() {
+			if def.OnTapped != nil {
+				def.OnTapped()
+			}
+			d.hideWithResponseIndex(idx)
+		})
+		btn.Importance = def.Importance
+		if def.Disabled {
+			btn.Disable()
+		}
+
+		d.buttons[i] = btn
+		row.Add(btn)
+	}
+
+	d.create(row)
+	return d
+}
+
 // ===============================================================
 // ThemedBackground
 // ===============================================================
@@ -145,6 +307,15 @@ func newThemedBackground() *themedBackground {
 	return t
 }
 
+// NewThemedBackground returns a widget that fills its allocated space with the theme's overlay
+// background colour, as used behind the content of a standard Dialog. It is most useful stacked
+// underneath other content, for example as a screen package "themed background" decorator.
+//
+// Since: 2.3
+func NewThemedBackground() fyne.CanvasObject {
+	return newThemedBackground()
+}
+
 func (t *themedBackground) CreateRenderer() fyne.WidgetRenderer {
 	t.ExtendBaseWidget(t)
 	rect := canvas.NewRectangle(theme.OverlayBackgroundColor())
@@ -200,7 +371,8 @@ func (l *dialogLayout) Layout(obj []fyne.CanvasObject, size fyne.Size) {
 	obj[3].Resize(btnMin)
 	obj[3].Move(fyne.NewPos(size.Width/2-(btnMin.Width/2), size.Height-padHeight-btnMin.Height))
 
-	// content
+	// content - clamped to the space left once the label and button row are accounted for,
+	// so oversized content scrolls instead of pushing the dialog past the window edge
 	contentStart := l.d.label.Position().Y + l.d.label.MinSize().Height + padHeight
 	contentEnd := obj[3].Position().Y - theme.Padding()
 	obj[2].Move(fyne.NewPos(padWidth/2, l.d.label.MinSize().Height+padHeight))
@@ -208,11 +380,36 @@ func (l *dialogLayout) Layout(obj []fyne.CanvasObject, size fyne.Size) {
 }
 
 func (l *dialogLayout) MinSize(obj []fyne.CanvasObject) fyne.Size {
-	contentMin := obj[2].MinSize()
+	// Measured from the content itself, not obj[2] (d.contentScroll) - a container.Scroll
+	// always reports a small fixed MinSize regardless of its child, so the dialog's size must
+	// be driven by the real content size and only clamped below when it would overflow.
+	contentMin := l.d.content.MinSize()
+	if !l.d.minContentSize.IsZero() {
+		contentMin = fyne.NewSize(fyne.Max(contentMin.Width, l.d.minContentSize.Width),
+			fyne.Max(contentMin.Height, l.d.minContentSize.Height))
+	}
+
 	btnMin := obj[3].MinSize()
+	labelMin := l.d.label.MinSize()
+
+	// When content is scrollable, don't let its MinSize push the dialog bigger than the
+	// parent canvas - clamp to the space left over after the label and button row.
+	if l.d.scrollDir != container.ScrollNone && l.d.parent != nil {
+		if canvasSize := l.d.parent.Canvas().Size(); !canvasSize.IsZero() {
+			maxWidth := canvasSize.Width - padWidth
+			maxHeight := canvasSize.Height - btnMin.Height - labelMin.Height - theme.Padding() - padHeight*2
+
+			if l.d.scrollDir != container.ScrollHorizontalOnly && contentMin.Height > maxHeight {
+				contentMin.Height = maxHeight
+			}
+			if l.d.scrollDir != container.ScrollVerticalOnly && contentMin.Width > maxWidth {
+				contentMin.Width = maxWidth
+			}
+		}
+	}
 
 	width := fyne.Max(fyne.Max(contentMin.Width, btnMin.Width), obj[4].MinSize().Width) + padWidth
-	height := contentMin.Height + btnMin.Height + l.d.label.MinSize().Height + theme.Padding() + padHeight*2
+	height := contentMin.Height + btnMin.Height + labelMin.Height + theme.Padding() + padHeight*2
 
 	return fyne.NewSize(width, height)
 }
@@ -0,0 +1,41 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestDialog_MinSize_MatchesContent(t *testing.T) {
+	test.NewApp()
+	win := test.NewWindow(widget.NewLabel("base"))
+	win.Resize(fyne.NewSize(800, 600))
+
+	content := container.New(layout.NewMinSizeLayout(fyne.NewSize(400, 300), layout.NewStackLayout()), widget.NewLabel("hi"))
+	d := NewCustomMulti("title", []*DialogButton{{Label: "OK"}}, content, win)
+
+	min := d.MinSize()
+	assert.GreaterOrEqual(t, min.Width, float32(400))
+	assert.GreaterOrEqual(t, min.Height, float32(300))
+}
+
+func TestDialog_SetMinContentSize_FloorsReportedSize(t *testing.T) {
+	test.NewApp()
+	win := test.NewWindow(widget.NewLabel("base"))
+	win.Resize(fyne.NewSize(800, 600))
+
+	d := NewCustomMulti("title", []*DialogButton{{Label: "OK"}}, widget.NewLabel("hi"), win)
+
+	before := d.MinSize()
+	d.SetMinContentSize(fyne.NewSize(500, 400))
+	after := d.MinSize()
+
+	assert.Greater(t, after.Width, before.Width)
+	assert.Greater(t, after.Height, before.Height)
+}
@@ -0,0 +1,29 @@
+package layout
+
+import "fyne.io/fyne/v2"
+
+type minSizeLayout struct {
+	min   fyne.Size
+	inner fyne.Layout
+}
+
+// NewMinSizeLayout returns a layout that wraps inner, enforcing a floor of min on the reported
+// MinSize. This replaces the common idiom of inserting an invisible canvas.Rectangle sized with
+// SetMinSize purely to force a container wide or tall enough for its content.
+//
+// Since: 2.3
+func NewMinSizeLayout(min fyne.Size, inner fyne.Layout) fyne.Layout {
+	return &minSizeLayout{min: min, inner: inner}
+}
+
+// Layout delegates to the wrapped layout unchanged.
+func (m *minSizeLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	m.inner.Layout(objects, size)
+}
+
+// MinSize returns the wrapped layout's MinSize, floored at min in each dimension.
+func (m *minSizeLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	inner := m.inner.MinSize(objects)
+
+	return fyne.NewSize(fyne.Max(m.min.Width, inner.Width), fyne.Max(m.min.Height, inner.Height))
+}
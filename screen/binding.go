@@ -0,0 +1,48 @@
+package screen
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/widget"
+)
+
+// BindingHandler is a ScreenHandler that resolves widget IDs to two-way data bindings, wiring
+// Entry, Label and Check nodes up to the matching binding.DataItem automatically.
+//
+// Since: 2.3
+type BindingHandler struct {
+	Bindings map[string]binding.DataItem
+}
+
+// NewBindingHandler returns a ScreenHandler backed by the given named data bindings.
+//
+// Since: 2.3
+func NewBindingHandler(bindings map[string]binding.DataItem) *BindingHandler {
+	return &BindingHandler{Bindings: bindings}
+}
+
+// Resolve implements ScreenHandler by building the widget matching node.Type bound to the data
+// item registered under id, or nil if there is no binding or no matching widget constructor.
+func (h *BindingHandler) Resolve(id string, n *Node) fyne.CanvasObject {
+	item, ok := h.Bindings[id]
+	if !ok {
+		return nil
+	}
+
+	switch n.Type {
+	case "Entry":
+		if str, ok := item.(binding.String); ok {
+			return widget.NewEntryWithData(str)
+		}
+	case "Label":
+		if str, ok := item.(binding.String); ok {
+			return widget.NewLabelWithData(str)
+		}
+	case "Check":
+		if b, ok := item.(binding.Bool); ok {
+			return widget.NewCheckWithData(n.Text, b)
+		}
+	}
+
+	return nil
+}
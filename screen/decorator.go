@@ -0,0 +1,44 @@
+package screen
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+)
+
+// Decorator wraps a built fyne.CanvasObject, applied after a node is constructed - for example to
+// add a border, padding or a themed background.
+//
+// Since: 2.3
+type Decorator func(fyne.CanvasObject) fyne.CanvasObject
+
+var decorators = map[string]Decorator{
+	"padded": func(obj fyne.CanvasObject) fyne.CanvasObject {
+		return container.NewPadded(obj)
+	},
+	"border": func(obj fyne.CanvasObject) fyne.CanvasObject {
+		return container.NewBorder(nil, nil, nil, nil, obj)
+	},
+	"themed background": func(obj fyne.CanvasObject) fyne.CanvasObject {
+		return container.New(layout.NewStackLayout(), dialog.NewThemedBackground(), obj)
+	},
+}
+
+// RegisterDecorator makes a named decorator available to layout descriptions via a node's
+// "decorators" list. Registering a name that already exists replaces it.
+//
+// Since: 2.3
+func RegisterDecorator(name string, decorator Decorator) {
+	decorators[name] = decorator
+}
+
+func decorate(obj fyne.CanvasObject, names []string) fyne.CanvasObject {
+	for _, name := range names {
+		if decorator, ok := decorators[name]; ok {
+			obj = decorator(obj)
+		}
+	}
+
+	return obj
+}
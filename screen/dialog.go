@@ -0,0 +1,26 @@
+package screen
+
+import "fyne.io/fyne/v2"
+
+// DialogScreen is a screen built from a layout description, pairing the built fyne.CanvasObject
+// with the handler used to build it. Pass ds.Content as the content argument of dialog.NewCustom
+// or dialog.NewCustomMulti; DialogScreen itself is not a fyne.CanvasObject.
+//
+// Since: 2.3
+type DialogScreen struct {
+	Content fyne.CanvasObject
+	Handler ScreenHandler
+}
+
+// NewDialogScreenHandler parses a layout description and wraps the resulting screen as a
+// DialogScreen, so its Content can be passed straight to a dialog constructor.
+//
+// Since: 2.3
+func NewDialogScreenHandler(handler ScreenHandler, data []byte) (*DialogScreen, error) {
+	content, err := Build(handler, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DialogScreen{Content: content, Handler: handler}, nil
+}
@@ -0,0 +1,175 @@
+// Package screen provides a declarative way to build a fyne.CanvasObject tree from an external
+// layout description, so that UI structure can be edited and reloaded without recompiling.
+package screen // import "fyne.io/fyne/v2/screen"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ScreenHandler resolves the widget IDs declared in a layout description to application state, so
+// that built widgets can be wired up for two-way data binding. Returning nil from Resolve lets
+// Build construct a plain default widget from the node's own fields instead.
+//
+// Since: 2.3
+type ScreenHandler interface {
+	Resolve(id string, node *Node) fyne.CanvasObject
+}
+
+// Node describes a single entry in a layout tree: either a container with children, or a leaf
+// widget. The set of supported Type values is VBox, HBox, Border, Grid, HScroll, VScroll, Scroll,
+// Label, Button and Entry. A ScreenHandler may resolve other Types too, such as the Check nodes
+// built by BindingHandler; without a handler result these fail with an unknown node type error.
+//
+// Since: 2.3
+type Node struct {
+	ID         string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Type       string   `json:"type" yaml:"type"`
+	Text       string   `json:"text,omitempty" yaml:"text,omitempty"`
+	Decorators []string `json:"decorators,omitempty" yaml:"decorators,omitempty"`
+	Children   []*Node  `json:"children,omitempty" yaml:"children,omitempty"`
+
+	// Columns sets the column count for a "Grid" node. If zero, the grid falls back to one
+	// column per child, giving a single row.
+	Columns int `json:"columns,omitempty" yaml:"columns,omitempty"`
+}
+
+// Build parses a JSON or YAML layout description and returns the fyne.CanvasObject tree it
+// describes, resolving widget IDs against handler. handler may be nil, in which case every node
+// falls back to its default widget. The format is detected from data: it is parsed as JSON if its
+// first non-whitespace byte is '{', and as YAML otherwise.
+//
+// Since: 2.3
+func Build(handler ScreenHandler, data []byte) (fyne.CanvasObject, error) {
+	root, err := parseNode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildNode(handler, root)
+}
+
+func parseNode(data []byte) (*Node, error) {
+	var root Node
+
+	if isJSON(data) {
+		if err := json.Unmarshal(data, &root); err != nil {
+			return nil, fmt.Errorf("screen: failed to parse layout: %w", err)
+		}
+		return &root, nil
+	}
+
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("screen: failed to parse layout: %w", err)
+	}
+	return &root, nil
+}
+
+func isJSON(data []byte) bool {
+	trimmed := bytes.TrimSpace(data)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+func buildNode(handler ScreenHandler, n *Node) (fyne.CanvasObject, error) {
+	if handler != nil && n.ID != "" {
+		if obj := handler.Resolve(n.ID, n); obj != nil {
+			return decorate(obj, n.Decorators), nil
+		}
+	}
+
+	obj, err := newNodeObject(handler, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return decorate(obj, n.Decorators), nil
+}
+
+func newNodeObject(handler ScreenHandler, n *Node) (fyne.CanvasObject, error) {
+	switch n.Type {
+	case "VBox", "HBox", "Border", "Grid", "HScroll", "VScroll", "Scroll":
+		return buildContainer(handler, n)
+	case "Label":
+		return widget.NewLabel(n.Text), nil
+	case "Button":
+		return widget.NewButton(n.Text, func() {}), nil
+	case "Entry":
+		return widget.NewEntry(), nil
+	default:
+		return nil, fmt.Errorf("screen: unknown node type %q", n.Type)
+	}
+}
+
+func buildContainer(handler ScreenHandler, n *Node) (fyne.CanvasObject, error) {
+	children := make([]fyne.CanvasObject, len(n.Children))
+	for i, child := range n.Children {
+		obj, err := buildNode(handler, child)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = obj
+	}
+
+	switch n.Type {
+	case "VBox":
+		return container.NewVBox(children...), nil
+	case "HBox":
+		return container.NewHBox(children...), nil
+	case "Grid":
+		columns := n.Columns
+		if columns <= 0 {
+			columns = len(children)
+		}
+		if columns <= 0 {
+			columns = 1
+		}
+		return container.NewGridWithColumns(columns, children...), nil
+	case "HScroll":
+		return container.NewHScroll(firstOrNil(children)), nil
+	case "VScroll":
+		return container.NewVScroll(firstOrNil(children)), nil
+	case "Scroll":
+		return container.NewScroll(firstOrNil(children)), nil
+	case "Border":
+		return buildBorder(children), nil
+	default:
+		return nil, fmt.Errorf("screen: unknown container type %q", n.Type)
+	}
+}
+
+func firstOrNil(objs []fyne.CanvasObject) fyne.CanvasObject {
+	if len(objs) == 0 {
+		return nil
+	}
+	return objs[0]
+}
+
+// buildBorder maps a Border node's children, in order, onto container.NewBorder's
+// top/bottom/left/right arguments - any children beyond the first four all become center objects.
+func buildBorder(children []fyne.CanvasObject) fyne.CanvasObject {
+	var top, bottom, left, right fyne.CanvasObject
+	var center []fyne.CanvasObject
+	for i, obj := range children {
+		switch i {
+		case 0:
+			top = obj
+		case 1:
+			bottom = obj
+		case 2:
+			left = obj
+		case 3:
+			right = obj
+		default:
+			center = append(center, obj)
+		}
+	}
+
+	return container.NewBorder(top, bottom, left, right, center...)
+}
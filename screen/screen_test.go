@@ -0,0 +1,104 @@
+package screen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestBuild_JSON(t *testing.T) {
+	test.NewApp()
+
+	obj, err := Build(nil, []byte(`{"type":"Label","text":"hi"}`))
+	assert.NoError(t, err)
+
+	label, ok := obj.(*widget.Label)
+	assert.True(t, ok)
+	assert.Equal(t, "hi", label.Text)
+}
+
+func TestBuild_YAML(t *testing.T) {
+	test.NewApp()
+
+	obj, err := Build(nil, []byte("type: Label\ntext: hi\n"))
+	assert.NoError(t, err)
+
+	label, ok := obj.(*widget.Label)
+	assert.True(t, ok)
+	assert.Equal(t, "hi", label.Text)
+}
+
+func TestBuildBorder_ExtraChildrenAllBecomeCenter(t *testing.T) {
+	test.NewApp()
+
+	obj, err := Build(nil, []byte(`{
+		"type": "Border",
+		"children": [
+			{"type": "Label", "text": "top"},
+			{"type": "Label", "text": "bottom"},
+			{"type": "Label", "text": "left"},
+			{"type": "Label", "text": "right"},
+			{"type": "Label", "text": "center1"},
+			{"type": "Label", "text": "center2"}
+		]
+	}`))
+	assert.NoError(t, err)
+
+	border, ok := obj.(*fyne.Container)
+	assert.True(t, ok)
+	assert.Len(t, border.Objects, 6)
+}
+
+func TestBuildGrid_EmptyDoesNotPanic(t *testing.T) {
+	test.NewApp()
+
+	assert.NotPanics(t, func() {
+		_, err := Build(nil, []byte(`{"type":"Grid","children":[]}`))
+		assert.NoError(t, err)
+	})
+}
+
+func TestBuildGrid_ColumnsFromNode(t *testing.T) {
+	test.NewApp()
+
+	obj, err := Build(nil, []byte(`{
+		"type": "Grid",
+		"columns": 2,
+		"children": [
+			{"type": "Label", "text": "a"},
+			{"type": "Label", "text": "b"},
+			{"type": "Label", "text": "c"}
+		]
+	}`))
+	assert.NoError(t, err)
+
+	grid, ok := obj.(*fyne.Container)
+	assert.True(t, ok)
+	assert.Len(t, grid.Objects, 3)
+
+	twoColumns := container.NewGridWithColumns(2, widget.NewLabel("a"), widget.NewLabel("b"), widget.NewLabel("c"))
+	assert.Equal(t, twoColumns.MinSize(), grid.MinSize())
+}
+
+func TestDecorate_ThemedBackgroundWrapsContent(t *testing.T) {
+	test.NewApp()
+
+	obj, err := Build(nil, []byte(`{"type":"Label","text":"hi","decorators":["themed background"]}`))
+	assert.NoError(t, err)
+
+	wrapper, ok := obj.(*fyne.Container)
+	assert.True(t, ok)
+	assert.Len(t, wrapper.Objects, 2)
+}
+
+func TestBuild_UnknownNodeType(t *testing.T) {
+	test.NewApp()
+
+	_, err := Build(nil, []byte(`{"type":"Bogus"}`))
+	assert.Error(t, err)
+}